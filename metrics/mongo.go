@@ -0,0 +1,59 @@
+package metrics
+
+import (
+	"sync/atomic"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.mongodb.org/mongo-driver/event"
+)
+
+var (
+	mongoCheckoutDesc = prometheus.NewDesc("mongo_checkout_total", "Total number of connections checked out of the pool.", nil, nil)
+	mongoCheckinDesc  = prometheus.NewDesc("mongo_checkin_total", "Total number of connections returned to the pool.", nil, nil)
+	mongoPoolSizeDesc = prometheus.NewDesc("mongo_pool_size", "Current number of connections in the pool.", nil, nil)
+)
+
+// MongoPoolMonitor is a prometheus.Collector driven by mongo-driver pool
+// events. Pass its Monitor field to MongoConfig.Observability.PoolMonitor so
+// mongo.Connect wires it in, then register the collector itself with a
+// Prometheus registry.
+type MongoPoolMonitor struct {
+	Monitor *event.PoolMonitor
+
+	checkedOut int64
+	checkedIn  int64
+	poolSize   int64
+}
+
+// NewMongoPoolMonitor builds a MongoPoolMonitor tracking mongo_checkout_total,
+// mongo_checkin_total, and mongo_pool_size.
+func NewMongoPoolMonitor() *MongoPoolMonitor {
+	m := &MongoPoolMonitor{}
+	m.Monitor = &event.PoolMonitor{
+		Event: func(e *event.PoolEvent) {
+			switch e.Type {
+			case event.GetSucceeded:
+				atomic.AddInt64(&m.checkedOut, 1)
+			case event.ConnectionReturned:
+				atomic.AddInt64(&m.checkedIn, 1)
+			case event.ConnectionCreated:
+				atomic.AddInt64(&m.poolSize, 1)
+			case event.ConnectionClosed:
+				atomic.AddInt64(&m.poolSize, -1)
+			}
+		},
+	}
+	return m
+}
+
+func (m *MongoPoolMonitor) Describe(ch chan<- *prometheus.Desc) {
+	ch <- mongoCheckoutDesc
+	ch <- mongoCheckinDesc
+	ch <- mongoPoolSizeDesc
+}
+
+func (m *MongoPoolMonitor) Collect(ch chan<- prometheus.Metric) {
+	ch <- prometheus.MustNewConstMetric(mongoCheckoutDesc, prometheus.CounterValue, float64(atomic.LoadInt64(&m.checkedOut)))
+	ch <- prometheus.MustNewConstMetric(mongoCheckinDesc, prometheus.CounterValue, float64(atomic.LoadInt64(&m.checkedIn)))
+	ch <- prometheus.MustNewConstMetric(mongoPoolSizeDesc, prometheus.GaugeValue, float64(atomic.LoadInt64(&m.poolSize)))
+}
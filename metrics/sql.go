@@ -0,0 +1,40 @@
+// Package metrics publishes Prometheus gauges for the connection pools
+// managed by sql.DBManager and mongo.Client.
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	dbsql "github.com/kerimovok/go-pkg-database/sql"
+)
+
+var (
+	sqlOpenConnsDesc    = prometheus.NewDesc("sql_open_conns", "Number of established connections, both in use and idle.", nil, nil)
+	sqlInUseDesc        = prometheus.NewDesc("sql_in_use", "Number of connections currently in use.", nil, nil)
+	sqlWaitCountDesc    = prometheus.NewDesc("sql_wait_count", "Total number of connections waited for.", nil, nil)
+	sqlWaitDurationDesc = prometheus.NewDesc("sql_wait_duration_seconds", "Total time blocked waiting for a new connection.", nil, nil)
+)
+
+// Register publishes connection-pool gauges sourced from dm.Stats() to reg.
+func Register(reg prometheus.Registerer, dm *dbsql.DBManager) error {
+	return reg.Register(&sqlCollector{dm: dm})
+}
+
+type sqlCollector struct {
+	dm *dbsql.DBManager
+}
+
+func (c *sqlCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- sqlOpenConnsDesc
+	ch <- sqlInUseDesc
+	ch <- sqlWaitCountDesc
+	ch <- sqlWaitDurationDesc
+}
+
+func (c *sqlCollector) Collect(ch chan<- prometheus.Metric) {
+	stats := c.dm.Stats()
+	ch <- prometheus.MustNewConstMetric(sqlOpenConnsDesc, prometheus.GaugeValue, float64(stats.OpenConnections))
+	ch <- prometheus.MustNewConstMetric(sqlInUseDesc, prometheus.GaugeValue, float64(stats.InUse))
+	ch <- prometheus.MustNewConstMetric(sqlWaitCountDesc, prometheus.GaugeValue, float64(stats.WaitCount))
+	ch <- prometheus.MustNewConstMetric(sqlWaitDurationDesc, prometheus.GaugeValue, stats.WaitDuration.Seconds())
+}
@@ -0,0 +1,148 @@
+package mongo
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/gridfs"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/mongo/readconcern"
+	"go.mongodb.org/mongo-driver/mongo/writeconcern"
+)
+
+// GridFSConfig configures the GridFS buckets opened for a Client
+type GridFSConfig struct {
+	BucketName     string
+	ChunkSizeBytes int32
+	WriteConcern   *writeconcern.WriteConcern
+	ReadConcern    *readconcern.ReadConcern
+	ReadPreference string // primary, secondary, primaryPreferred, secondaryPreferred, nearest
+}
+
+func (c GridFSConfig) setDefaults() GridFSConfig {
+	if c.BucketName == "" {
+		c.BucketName = "fs"
+	}
+	if c.ChunkSizeBytes <= 0 {
+		c.ChunkSizeBytes = 255 * 1024
+	}
+	return c
+}
+
+// Bucket wraps a GridFS bucket and provides additional functionality
+type Bucket struct {
+	bucket *gridfs.Bucket
+	name   string
+}
+
+// GridFS returns the default GridFS bucket, configured via MongoConfig.GridFSConfig
+func (c *Client) GridFS() (*Bucket, error) {
+	cfg := c.config.GridFSConfig.setDefaults()
+	return c.NamedBucket(cfg.BucketName)
+}
+
+// NamedBucket returns a GridFS bucket with the given name, falling back to the
+// Client's GridFSConfig for chunk size, write concern, and read concern so
+// multiple buckets can coexist in the same database.
+func (c *Client) NamedBucket(name string) (*Bucket, error) {
+	db := c.Database()
+	if db == nil {
+		return nil, fmt.Errorf("client not initialized")
+	}
+
+	cfg := c.config.GridFSConfig.setDefaults()
+
+	opts := options.GridFSBucket().SetName(name).SetChunkSizeBytes(cfg.ChunkSizeBytes)
+	if cfg.WriteConcern != nil {
+		opts.SetWriteConcern(cfg.WriteConcern)
+	}
+	if cfg.ReadConcern != nil {
+		opts.SetReadConcern(cfg.ReadConcern)
+	}
+	if cfg.ReadPreference != "" {
+		readPref, err := (MongoConfig{ReadPreference: cfg.ReadPreference}).getReadPreference()
+		if err != nil {
+			return nil, fmt.Errorf("failed to set read preference: %w", err)
+		}
+		opts.SetReadPreference(readPref)
+	}
+
+	bucket, err := gridfs.NewBucket(db, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open GridFS bucket %q: %w", name, err)
+	}
+
+	return &Bucket{bucket: bucket, name: name}, nil
+}
+
+// Name returns the bucket's name
+func (b *Bucket) Name() string {
+	return b.name
+}
+
+// UploadFromReader uploads a file to the bucket, reading its contents from r
+func (b *Bucket) UploadFromReader(ctx context.Context, filename string, r io.Reader, metadata bson.M) (primitive.ObjectID, error) {
+	var opts *options.UploadOptions
+	if metadata != nil {
+		opts = options.GridFSUpload().SetMetadata(metadata)
+	}
+
+	oid := primitive.NewObjectID()
+	if err := b.bucket.UploadFromStreamWithID(oid, filename, r, opts); err != nil {
+		return primitive.NilObjectID, fmt.Errorf("failed to upload %q to GridFS: %w", filename, err)
+	}
+
+	return oid, nil
+}
+
+// DownloadToWriter writes the contents of the file identified by id to w
+func (b *Bucket) DownloadToWriter(ctx context.Context, id primitive.ObjectID, w io.Writer) error {
+	if _, err := b.bucket.DownloadToStream(id, w); err != nil {
+		return fmt.Errorf("failed to download %s from GridFS: %w", id.Hex(), err)
+	}
+	return nil
+}
+
+// OpenUploadStream opens a stream for uploading a file in chunks
+func (b *Bucket) OpenUploadStream(ctx context.Context, filename string, metadata bson.M) (*gridfs.UploadStream, error) {
+	var opts *options.UploadOptions
+	if metadata != nil {
+		opts = options.GridFSUpload().SetMetadata(metadata)
+	}
+
+	stream, err := b.bucket.OpenUploadStream(filename, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open GridFS upload stream for %q: %w", filename, err)
+	}
+	return stream, nil
+}
+
+// OpenDownloadStream opens a stream for downloading a file in chunks
+func (b *Bucket) OpenDownloadStream(ctx context.Context, id primitive.ObjectID) (*gridfs.DownloadStream, error) {
+	stream, err := b.bucket.OpenDownloadStream(id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open GridFS download stream for %s: %w", id.Hex(), err)
+	}
+	return stream, nil
+}
+
+// DeleteFile removes a file and its chunks from the bucket
+func (b *Bucket) DeleteFile(ctx context.Context, id primitive.ObjectID) error {
+	if err := b.bucket.Delete(id); err != nil {
+		return fmt.Errorf("failed to delete %s from GridFS: %w", id.Hex(), err)
+	}
+	return nil
+}
+
+// Find returns the files collection documents matching filter
+func (b *Bucket) Find(ctx context.Context, filter interface{}) (*mongo.Cursor, error) {
+	cursor, err := b.bucket.Find(filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query GridFS files: %w", err)
+	}
+	return cursor, nil
+}
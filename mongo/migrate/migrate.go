@@ -0,0 +1,218 @@
+// Package migrate runs versioned migrations against a mongo.Client, tracking
+// applied versions in a "migrations" collection. It mirrors sql/migrate so
+// both backends are driven through the same Runner interface.
+package migrate
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	dbmongo "github.com/kerimovok/go-pkg-database/mongo"
+	sqlmigrate "github.com/kerimovok/go-pkg-database/sql/migrate"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Step applies or reverts a single migration against the configured database.
+type Step func(sc mongo.SessionContext, db *mongo.Database) error
+
+// IndexMigration declares indexes to create alongside a migration, via
+// Collection.Indexes().CreateMany, so index changes are versioned the same
+// way as document migrations.
+type IndexMigration struct {
+	Collection string
+	Models     []mongo.IndexModel
+}
+
+// Migration pairs an up/down Step with its version, name, and any index
+// changes to apply alongside it.
+type Migration struct {
+	Version int64
+	Name    string
+	Up      Step
+	Down    Step
+	Indexes []IndexMigration
+}
+
+var registry []Migration
+
+// Register adds a migration to the default registry, applied in version order.
+func Register(version int64, name string, up, down Step, indexes ...IndexMigration) {
+	registry = append(registry, Migration{Version: version, Name: name, Up: up, Down: down, Indexes: indexes})
+}
+
+type versionDoc struct {
+	Version   int64     `bson:"_id"`
+	Name      string    `bson:"name"`
+	AppliedAt time.Time `bson:"appliedAt"`
+}
+
+// DocumentRunner applies migrations to a MongoDB database. It satisfies
+// sql/migrate.Runner so callers can manage both backends through the same
+// interface.
+type DocumentRunner struct {
+	client     *dbmongo.Client
+	collection string
+	migrations []Migration
+}
+
+// New builds a DocumentRunner from the migrations registered via Register.
+func New(client *dbmongo.Client) (*DocumentRunner, error) {
+	if client == nil {
+		return nil, fmt.Errorf("mongo client is nil")
+	}
+
+	migrations := append([]Migration(nil), registry...)
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+
+	for i := 1; i < len(migrations); i++ {
+		if migrations[i].Version == migrations[i-1].Version {
+			return nil, fmt.Errorf("duplicate migration version %d", migrations[i].Version)
+		}
+	}
+
+	return &DocumentRunner{client: client, collection: "migrations", migrations: migrations}, nil
+}
+
+func (r *DocumentRunner) appliedVersions(ctx context.Context) (map[int64]versionDoc, error) {
+	cur, err := r.client.Collection(r.collection).Find(ctx, bson.M{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read migrations collection: %w", err)
+	}
+	defer cur.Close(ctx)
+
+	applied := map[int64]versionDoc{}
+	for cur.Next(ctx) {
+		var doc versionDoc
+		if err := cur.Decode(&doc); err != nil {
+			return nil, fmt.Errorf("failed to decode migration record: %w", err)
+		}
+		applied[doc.Version] = doc
+	}
+	return applied, cur.Err()
+}
+
+// Up applies all pending migrations up to and including target. A target of
+// 0 applies every pending migration.
+func (r *DocumentRunner) Up(ctx context.Context, target int64) error {
+	applied, err := r.appliedVersions(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, m := range r.migrations {
+		if target > 0 && m.Version > target {
+			break
+		}
+		if _, ok := applied[m.Version]; ok {
+			continue
+		}
+
+		if err := r.client.WithTransaction(ctx, func(sc mongo.SessionContext) error {
+			db := r.client.Database()
+			if m.Up != nil {
+				if err := m.Up(sc, db); err != nil {
+					return err
+				}
+			}
+			for _, idx := range m.Indexes {
+				if _, err := db.Collection(idx.Collection).Indexes().CreateMany(sc, idx.Models); err != nil {
+					return fmt.Errorf("failed to create indexes on %s: %w", idx.Collection, err)
+				}
+			}
+			_, err := r.client.Collection(r.collection).InsertOne(sc, versionDoc{
+				Version:   m.Version,
+				Name:      m.Name,
+				AppliedAt: time.Now(),
+			})
+			return err
+		}); err != nil {
+			return fmt.Errorf("failed to apply migration %d_%s: %w", m.Version, m.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// Down rolls back applied migrations down to (but not including) target.
+func (r *DocumentRunner) Down(ctx context.Context, target int64) error {
+	applied, err := r.appliedVersions(ctx)
+	if err != nil {
+		return err
+	}
+
+	for i := len(r.migrations) - 1; i >= 0; i-- {
+		m := r.migrations[i]
+		if m.Version <= target {
+			continue
+		}
+		if _, ok := applied[m.Version]; !ok {
+			continue
+		}
+
+		if err := r.client.WithTransaction(ctx, func(sc mongo.SessionContext) error {
+			if m.Down != nil {
+				if err := m.Down(sc, r.client.Database()); err != nil {
+					return err
+				}
+			}
+			_, err := r.client.Collection(r.collection).DeleteOne(sc, bson.M{"_id": m.Version})
+			return err
+		}); err != nil {
+			return fmt.Errorf("failed to roll back migration %d_%s: %w", m.Version, m.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// Status reports the up/down state of every known migration.
+func (r *DocumentRunner) Status(ctx context.Context) ([]sqlmigrate.MigrationStatus, error) {
+	applied, err := r.appliedVersions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]sqlmigrate.MigrationStatus, 0, len(r.migrations))
+	for _, m := range r.migrations {
+		status := sqlmigrate.MigrationStatus{Version: m.Version, Name: m.Name}
+		if doc, ok := applied[m.Version]; ok {
+			status.Applied = true
+			appliedAt := doc.AppliedAt
+			status.AppliedAt = &appliedAt
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
+
+// Force rewrites the migrations collection to mark version as the latest
+// applied migration without running any steps, for repairing a dirty state.
+func (r *DocumentRunner) Force(ctx context.Context, version int64) error {
+	collection := r.client.Collection(r.collection)
+
+	if _, err := collection.DeleteMany(ctx, bson.M{"_id": bson.M{"$gt": version}}); err != nil {
+		return fmt.Errorf("failed to clear migrations above %d: %w", version, err)
+	}
+
+	for _, m := range r.migrations {
+		if m.Version > version {
+			continue
+		}
+		_, err := collection.UpdateOne(ctx,
+			bson.M{"_id": m.Version},
+			bson.M{"$setOnInsert": versionDoc{Version: m.Version, Name: m.Name, AppliedAt: time.Now()}},
+			options.Update().SetUpsert(true),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to force migration %d_%s: %w", m.Version, m.Name, err)
+		}
+	}
+
+	return nil
+}
+
+var _ sqlmigrate.Runner = (*DocumentRunner)(nil)
@@ -5,6 +5,9 @@ import (
 	"fmt"
 	"time"
 
+	"go.opentelemetry.io/contrib/instrumentation/go.mongodb.org/mongo-driver/mongo/otelmongo"
+
+	"go.mongodb.org/mongo-driver/event"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"go.mongodb.org/mongo-driver/mongo/readpref"
@@ -21,6 +24,20 @@ type MongoConfig struct {
 	ReadPreference string // primary, secondary, primaryPreferred, secondaryPreferred, nearest
 	RetryWrites    bool
 	RetryReads     bool
+	GridFSConfig   GridFSConfig
+	Observability  ObservabilityConfig
+}
+
+// ObservabilityConfig controls optional tracing and pool-metrics
+// instrumentation for a Client. It is opt-in so existing callers are
+// unaffected.
+type ObservabilityConfig struct {
+	// Tracing registers the official otelmongo command monitor, emitting an
+	// OpenTelemetry span for every command sent to the server.
+	Tracing bool
+	// PoolMonitor, if set, is registered on the client's connection pool.
+	// Use metrics.NewMongoPoolMonitor().Monitor to publish pool gauges.
+	PoolMonitor *event.PoolMonitor
 }
 
 // Client wraps mongo.Client and provides additional functionality
@@ -115,6 +132,14 @@ func Connect(cfg MongoConfig) (*Client, error) {
 	opts.SetRetryWrites(cfg.RetryWrites)
 	opts.SetRetryReads(cfg.RetryReads)
 
+	// Wire observability, if enabled
+	if cfg.Observability.Tracing {
+		opts.SetMonitor(otelmongo.NewMonitor())
+	}
+	if cfg.Observability.PoolMonitor != nil {
+		opts.SetPoolMonitor(cfg.Observability.PoolMonitor)
+	}
+
 	client, err := mongo.Connect(ctx, opts)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to MongoDB: %w", err)
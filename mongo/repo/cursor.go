@@ -0,0 +1,124 @@
+package repo
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Cursor is an opaque pagination position over an indexed (createdAt, _id)
+// tuple, avoiding offset scans on large collections. ID is kept as a
+// bson.RawValue, preserving _id's native BSON type (commonly
+// primitive.ObjectID), so the tie-break comparison in Paginate compares
+// like with like instead of against a stringified approximation.
+type Cursor struct {
+	CreatedAt time.Time     `bson:"createdAt"`
+	ID        bson.RawValue `bson:"id"`
+}
+
+func encodeCursor(c Cursor) string {
+	data, _ := bson.Marshal(c)
+	return base64.URLEncoding.EncodeToString(data)
+}
+
+func decodeCursor(s string) (Cursor, error) {
+	var c Cursor
+	data, err := base64.URLEncoding.DecodeString(s)
+	if err != nil {
+		return c, fmt.Errorf("invalid cursor: %w", err)
+	}
+	if err := bson.Unmarshal(data, &c); err != nil {
+		return c, fmt.Errorf("invalid cursor: %w", err)
+	}
+	return c, nil
+}
+
+// Page is a cursor-paginated result set.
+type Page[T any] struct {
+	Items      []T
+	NextCursor string
+	HasMore    bool
+}
+
+// Paginate returns up to limit documents matching filter created after
+// cursor (pass "" for the first page), ordered by (createdAt, _id)
+// ascending. Documents must have "createdAt" and "_id" fields.
+func (r *Repository[T]) Paginate(ctx context.Context, filter bson.M, limit int, cursor string) (*Page[T], error) {
+	query := cloneFilter(filter)
+
+	if cursor != "" {
+		c, err := decodeCursor(cursor)
+		if err != nil {
+			return nil, err
+		}
+		query["$or"] = []bson.M{
+			{"createdAt": bson.M{"$gt": c.CreatedAt}},
+			{"createdAt": c.CreatedAt, "_id": bson.M{"$gt": c.ID}},
+		}
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "createdAt", Value: 1}, {Key: "_id", Value: 1}}).
+		SetLimit(int64(limit) + 1)
+
+	items, err := r.Find(ctx, query, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &Page[T]{}
+	if len(items) > limit {
+		page.HasMore = true
+		items = items[:limit]
+	}
+	page.Items = items
+
+	if len(items) > 0 {
+		createdAt, id, err := cursorFields(items[len(items)-1])
+		if err != nil {
+			return nil, err
+		}
+		page.NextCursor = encodeCursor(Cursor{CreatedAt: createdAt, ID: id})
+	}
+
+	return page, nil
+}
+
+func cloneFilter(filter bson.M) bson.M {
+	clone := make(bson.M, len(filter)+1)
+	for k, v := range filter {
+		clone[k] = v
+	}
+	return clone
+}
+
+// cursorFields extracts createdAt and _id from m as their native BSON types,
+// so the cursor built from them can be compared against other documents'
+// _id values without a lossy round trip through a string.
+func cursorFields(m any) (time.Time, bson.RawValue, error) {
+	data, err := bson.Marshal(m)
+	if err != nil {
+		return time.Time{}, bson.RawValue{}, fmt.Errorf("failed to inspect document for cursor: %w", err)
+	}
+	doc := bson.Raw(data)
+
+	createdAt, err := doc.LookupErr("createdAt")
+	if err != nil {
+		return time.Time{}, bson.RawValue{}, fmt.Errorf("cursor pagination requires a createdAt field: %w", err)
+	}
+	t, ok := createdAt.TimeOK()
+	if !ok {
+		return time.Time{}, bson.RawValue{}, fmt.Errorf("cursor pagination requires createdAt to be a date")
+	}
+
+	id, err := doc.LookupErr("_id")
+	if err != nil {
+		return time.Time{}, bson.RawValue{}, fmt.Errorf("cursor pagination requires an _id field: %w", err)
+	}
+
+	return t, id, nil
+}
@@ -0,0 +1,71 @@
+package repo
+
+import (
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestCursorRoundTripPreservesIDType(t *testing.T) {
+	oid := primitive.NewObjectID()
+	createdAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	encoded := encodeCursor(Cursor{CreatedAt: createdAt, ID: rawValueFor(t, oid)})
+
+	decoded, err := decodeCursor(encoded)
+	if err != nil {
+		t.Fatalf("decodeCursor: %v", err)
+	}
+	if !decoded.CreatedAt.Equal(createdAt) {
+		t.Fatalf("expected createdAt %v, got %v", createdAt, decoded.CreatedAt)
+	}
+
+	var gotOID primitive.ObjectID
+	if err := decoded.ID.Unmarshal(&gotOID); err != nil {
+		t.Fatalf("expected decoded id to unmarshal as an ObjectID: %v", err)
+	}
+	if gotOID != oid {
+		t.Fatalf("expected id %s, got %s", oid, gotOID)
+	}
+}
+
+func TestCursorFieldsPreservesNativeIDType(t *testing.T) {
+	doc := struct {
+		ID        primitive.ObjectID `bson:"_id"`
+		CreatedAt time.Time          `bson:"createdAt"`
+	}{
+		ID:        primitive.NewObjectID(),
+		CreatedAt: time.Now().UTC().Truncate(time.Millisecond),
+	}
+
+	createdAt, id, err := cursorFields(doc)
+	if err != nil {
+		t.Fatalf("cursorFields: %v", err)
+	}
+	if !createdAt.Equal(doc.CreatedAt) {
+		t.Fatalf("expected createdAt %v, got %v", doc.CreatedAt, createdAt)
+	}
+
+	var gotOID primitive.ObjectID
+	if err := id.Unmarshal(&gotOID); err != nil {
+		t.Fatalf("expected id to unmarshal as an ObjectID: %v", err)
+	}
+	if gotOID != doc.ID {
+		t.Fatalf("expected id %s, got %s", doc.ID, gotOID)
+	}
+}
+
+func rawValueFor(t *testing.T, v interface{}) bson.RawValue {
+	t.Helper()
+	data, err := bson.Marshal(bson.M{"v": v})
+	if err != nil {
+		t.Fatalf("bson.Marshal: %v", err)
+	}
+	rv, err := bson.Raw(data).LookupErr("v")
+	if err != nil {
+		t.Fatalf("LookupErr: %v", err)
+	}
+	return rv
+}
@@ -0,0 +1,119 @@
+// Package repo mirrors sql/repo's generic repository surface on top of
+// mongo.Client, so callers get the same Create/Find/Paginate vocabulary
+// regardless of backend.
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	dbmongo "github.com/kerimovok/go-pkg-database/mongo"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Repository provides common CRUD and query operations for documents of
+// type T stored in a single collection.
+type Repository[T any] struct {
+	collection *mongo.Collection
+	sc         mongo.SessionContext
+}
+
+// New builds a Repository bound to the named collection on client.
+func New[T any](client *dbmongo.Client, collectionName string) *Repository[T] {
+	return &Repository[T]{collection: client.Collection(collectionName)}
+}
+
+// withSession returns the context that should drive an operation: the
+// transaction's SessionContext if this Repository was built via Of inside
+// a UnitOfWork, so its writes can't accidentally land outside the
+// transaction regardless of what ctx a caller passes, or ctx itself
+// otherwise.
+func (r *Repository[T]) withSession(ctx context.Context) context.Context {
+	if r.sc != nil {
+		return r.sc
+	}
+	return ctx
+}
+
+// Create inserts m.
+func (r *Repository[T]) Create(ctx context.Context, m *T) error {
+	if _, err := r.collection.InsertOne(r.withSession(ctx), m); err != nil {
+		return fmt.Errorf("failed to insert %T: %w", *new(T), err)
+	}
+	return nil
+}
+
+// Update applies a partial $set update to the document identified by id.
+func (r *Repository[T]) Update(ctx context.Context, id interface{}, update bson.M) error {
+	if _, err := r.collection.UpdateOne(r.withSession(ctx), bson.M{"_id": id}, bson.M{"$set": update}); err != nil {
+		return fmt.Errorf("failed to update %T: %w", *new(T), err)
+	}
+	return nil
+}
+
+// Save upserts m as the document identified by id, replacing it wholesale.
+func (r *Repository[T]) Save(ctx context.Context, id interface{}, m *T) error {
+	if _, err := r.collection.ReplaceOne(r.withSession(ctx), bson.M{"_id": id}, m, options.Replace().SetUpsert(true)); err != nil {
+		return fmt.Errorf("failed to save %T: %w", *new(T), err)
+	}
+	return nil
+}
+
+// Delete removes the document identified by id.
+func (r *Repository[T]) Delete(ctx context.Context, id interface{}) error {
+	if _, err := r.collection.DeleteOne(r.withSession(ctx), bson.M{"_id": id}); err != nil {
+		return fmt.Errorf("failed to delete %T: %w", *new(T), err)
+	}
+	return nil
+}
+
+// FindByID loads the document identified by id.
+func (r *Repository[T]) FindByID(ctx context.Context, id interface{}) (*T, error) {
+	var m T
+	if err := r.collection.FindOne(r.withSession(ctx), bson.M{"_id": id}).Decode(&m); err != nil {
+		return nil, fmt.Errorf("failed to find %T by id: %w", m, err)
+	}
+	return &m, nil
+}
+
+// FindOne loads the first document matching filter.
+func (r *Repository[T]) FindOne(ctx context.Context, filter bson.M, opts ...*options.FindOneOptions) (*T, error) {
+	var m T
+	if err := r.collection.FindOne(r.withSession(ctx), filter, opts...).Decode(&m); err != nil {
+		return nil, fmt.Errorf("failed to find %T: %w", m, err)
+	}
+	return &m, nil
+}
+
+// Find loads every document matching filter.
+func (r *Repository[T]) Find(ctx context.Context, filter bson.M, opts ...*options.FindOptions) ([]T, error) {
+	ctx = r.withSession(ctx)
+	cur, err := r.collection.Find(ctx, filter, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find %T: %w", *new(T), err)
+	}
+	defer cur.Close(ctx)
+
+	var items []T
+	if err := cur.All(ctx, &items); err != nil {
+		return nil, fmt.Errorf("failed to decode %T results: %w", *new(T), err)
+	}
+	return items, nil
+}
+
+// Count returns the number of documents matching filter.
+func (r *Repository[T]) Count(ctx context.Context, filter bson.M) (int64, error) {
+	count, err := r.collection.CountDocuments(r.withSession(ctx), filter)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count %T: %w", *new(T), err)
+	}
+	return count, nil
+}
+
+// Exists reports whether any document matches filter.
+func (r *Repository[T]) Exists(ctx context.Context, filter bson.M) (bool, error) {
+	count, err := r.Count(ctx, filter)
+	return count > 0, err
+}
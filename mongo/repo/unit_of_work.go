@@ -0,0 +1,42 @@
+package repo
+
+import (
+	"context"
+
+	dbmongo "github.com/kerimovok/go-pkg-database/mongo"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// UnitOfWork carries the SessionContext for an in-flight mongo transaction,
+// so handlers can build repositories bound to it and compose multi-collection
+// writes without managing the session manually.
+type UnitOfWork struct {
+	client *dbmongo.Client
+	sc     mongo.SessionContext
+}
+
+// Do runs fn inside client.WithTransaction, handing it a UnitOfWork bound to
+// that transaction's SessionContext.
+func Do(ctx context.Context, client *dbmongo.Client, fn func(uow *UnitOfWork) error) error {
+	return client.WithTransaction(ctx, func(sc mongo.SessionContext) error {
+		return fn(&UnitOfWork{client: client, sc: sc})
+	})
+}
+
+// Context returns the transaction's SessionContext, for callers that need
+// to run a raw mongo operation (outside a Repository built with Of)
+// inside the transaction.
+func (uow *UnitOfWork) Context() mongo.SessionContext {
+	return uow.sc
+}
+
+// Of returns a Repository for model T bound to the named collection and to
+// the UnitOfWork's transaction: every operation on it runs through the
+// transaction's SessionContext regardless of the ctx callers pass in, the
+// same guarantee sql/repo.Of gets by binding its Repository to the tx's
+// *gorm.DB.
+func Of[T any](uow *UnitOfWork, collectionName string) *Repository[T] {
+	r := New[T](uow.client, collectionName)
+	r.sc = uow.sc
+	return r
+}
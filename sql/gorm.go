@@ -9,6 +9,7 @@ import (
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
+	"gorm.io/plugin/dbresolver"
 )
 
 type GormConfig struct {
@@ -27,20 +28,43 @@ type GormConfig struct {
 	LogLevel                  logger.LogLevel
 	SlowThreshold             time.Duration
 	IgnoreRecordNotFoundError bool
+	Replicas                  []ReplicaConfig
+	Observability             ObservabilityConfig
+}
+
+// ReplicaConfig describes the connection parameters for a read replica,
+// routed to via DBManager.Read() / dbresolver.Read.
+type ReplicaConfig struct {
+	Host     string
+	User     string
+	Password string
+	Name     string
+	Port     string
+	SSLMode  string
+	Timezone string
+}
+
+func (c ReplicaConfig) dsn() string {
+	return buildDSN(c.Host, c.User, c.Password, c.Name, c.Port, c.SSLMode, c.Timezone)
 }
 
 func (c GormConfig) dsn() string {
-	ssl := c.SSLMode
-	if ssl == "" {
-		ssl = "disable"
+	return buildDSN(c.Host, c.User, c.Password, c.Name, c.Port, c.SSLMode, c.Timezone)
+}
+
+// buildDSN assembles a postgres connection string, defaulting sslMode to
+// "disable" and timezone to "UTC" when unset. Shared by GormConfig and
+// ReplicaConfig so the primary and its replicas can't drift apart.
+func buildDSN(host, user, password, name, port, sslMode, timezone string) string {
+	if sslMode == "" {
+		sslMode = "disable"
 	}
-	tz := c.Timezone
-	if tz == "" {
-		tz = "UTC"
+	if timezone == "" {
+		timezone = "UTC"
 	}
 	return fmt.Sprintf(
 		"host=%s user=%s password=%s dbname=%s port=%s sslmode=%s TimeZone=%s",
-		c.Host, c.User, c.Password, c.Name, c.Port, ssl, tz,
+		host, user, password, name, port, sslMode, timezone,
 	)
 }
 
@@ -74,6 +98,26 @@ func (dm *DBManager) Stats() sql.DBStats {
 	return sql.DBStats{}
 }
 
+// Conn reserves a single connection from the pool, for callers that need to
+// hold server-side session state (e.g. an advisory lock) across statements.
+func (dm *DBManager) Conn(ctx context.Context) (*sql.Conn, error) {
+	if dm.sqlDB == nil {
+		return nil, fmt.Errorf("database connection not initialized")
+	}
+	return dm.sqlDB.Conn(ctx)
+}
+
+// Read returns a session pinned to a read replica, for explicit read-only
+// queries. It is a no-op when no replicas are configured.
+func (dm *DBManager) Read() *gorm.DB {
+	return dm.Clauses(dbresolver.Read)
+}
+
+// Write returns a session pinned to the primary, for explicit writes.
+func (dm *DBManager) Write() *gorm.DB {
+	return dm.Clauses(dbresolver.Write)
+}
+
 func (c GormConfig) validate() error {
 	if c.Host == "" {
 		return fmt.Errorf("host is required")
@@ -154,6 +198,37 @@ func OpenGorm(c GormConfig, automigrateModels ...interface{}) (*DBManager, error
 		return nil, fmt.Errorf("failed to ping database: %w", err)
 	}
 
+	// Route SELECTs to read replicas while writes and transactions stay on
+	// the primary
+	if len(c.Replicas) > 0 {
+		replicas := make([]gorm.Dialector, 0, len(c.Replicas))
+		for _, rc := range c.Replicas {
+			replicas = append(replicas, postgres.Open(rc.dsn()))
+		}
+
+		resolver := dbresolver.Register(dbresolver.Config{
+			Replicas: replicas,
+			Policy:   dbresolver.RandomPolicy{},
+		}).
+			SetMaxIdleConns(c.MaxIdleConns).
+			SetMaxOpenConns(c.MaxOpenConns).
+			SetConnMaxLifetime(c.ConnMaxLifetime).
+			SetConnMaxIdleTime(c.ConnMaxIdleTime)
+
+		if err := db.Use(resolver); err != nil {
+			sqlDB.Close()
+			return nil, fmt.Errorf("failed to register read replicas: %w", err)
+		}
+	}
+
+	// Wire OpenTelemetry tracing, if enabled
+	if c.Observability.Tracing {
+		if err := db.Use(tracingPlugin{slowThreshold: c.SlowThreshold}); err != nil {
+			sqlDB.Close()
+			return nil, fmt.Errorf("failed to register tracing plugin: %w", err)
+		}
+	}
+
 	// Auto-migrate models if provided
 	if len(automigrateModels) > 0 {
 		if err := db.AutoMigrate(automigrateModels...); err != nil {
@@ -55,6 +55,60 @@ func (j *JSONB) Scan(value any) error {
 	return nil
 }
 
+// JSONBOf is a generic PostgreSQL JSONB column that marshals/unmarshals a
+// concrete Go type instead of forcing callers to type-assert their way
+// through a map[string]any, the way JSONB does.
+type JSONBOf[T any] struct {
+	Data T
+}
+
+// Value implements the driver.Valuer interface
+func (j JSONBOf[T]) Value() (driver.Value, error) {
+	data, err := json.Marshal(j.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal JSONB: %w", err)
+	}
+	return data, nil
+}
+
+// Scan implements the sql.Scanner interface
+func (j *JSONBOf[T]) Scan(value any) error {
+	if value == nil {
+		var zero T
+		j.Data = zero
+		return nil
+	}
+
+	var data []byte
+	switch v := value.(type) {
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return fmt.Errorf("cannot scan %T into JSONB", value)
+	}
+
+	if len(data) == 0 {
+		var zero T
+		j.Data = zero
+		return nil
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(&j.Data); err != nil {
+		return fmt.Errorf("failed to unmarshal JSONB: %w", err)
+	}
+
+	return nil
+}
+
+// GormDataType tells AutoMigrate to use the jsonb column type for this field
+func (JSONBOf[T]) GormDataType() string {
+	return "jsonb"
+}
+
 // JSONBArray represents a PostgreSQL JSONB array
 type JSONBArray []any
 
@@ -0,0 +1,83 @@
+// Package jsonbq provides gorm clause builders for querying and updating
+// PostgreSQL JSONB columns without hand-writing raw SQL fragments.
+package jsonbq
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Path builds a column -> 'a' -> 'b' ->> 'c' expression, returning the text
+// value at the given path within column.
+func Path(column string, path ...string) clause.Expr {
+	if len(path) == 0 {
+		return gorm.Expr(column)
+	}
+
+	var b strings.Builder
+	b.WriteString(column)
+	for i := range path {
+		if i == len(path)-1 {
+			b.WriteString("->>?")
+		} else {
+			b.WriteString("->?")
+		}
+	}
+
+	args := make([]interface{}, len(path))
+	for i, p := range path {
+		args[i] = p
+	}
+
+	return gorm.Expr(b.String(), args...)
+}
+
+// Contains builds `column @> value::jsonb`, matching rows whose column
+// contains value.
+func Contains(column string, value interface{}) clause.Expr {
+	return gorm.Expr(fmt.Sprintf("%s @> ?::jsonb", column), toJSON(value))
+}
+
+// HasKey builds `column ? key`, matching rows where column has key as a
+// top-level key. key can't be passed as a bound Var here: clause.Expr.Build
+// scans the SQL for literal '?' bytes and consumes one Var per occurrence,
+// so there is no way to keep Postgres's own `?` operator literal while also
+// parameterizing a value — doubling it (`??`) just shifts which `?` eats
+// the Var instead of escaping anything. Instead key is embedded directly as
+// an escaped SQL string literal, with no Vars for Build to consume.
+func HasKey(column, key string) clause.Expr {
+	return gorm.Expr(fmt.Sprintf("%s ? %s", column, quoteLiteral(key)))
+}
+
+// quoteLiteral escapes s for embedding as a single-quoted SQL string
+// literal, doubling any embedded single quotes.
+func quoteLiteral(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
+
+// PathExists builds `column @? jsonpath::jsonpath`, matching rows where the
+// JSON path expression returns any item for column. As with HasKey, the
+// operator contains a literal '?', so jsonpath is embedded as an escaped SQL
+// string literal rather than bound as a Var.
+func PathExists(column, jsonpath string) clause.Expr {
+	return gorm.Expr(fmt.Sprintf("%s @? %s::jsonpath", column, quoteLiteral(jsonpath)))
+}
+
+// Set builds a jsonb_set(column, path, value) expression for use in an
+// Updates call, replacing (or creating) the value at path within column.
+func Set(column string, path []string, value interface{}) clause.Expr {
+	pgPath := "{" + strings.Join(path, ",") + "}"
+	return gorm.Expr(fmt.Sprintf("jsonb_set(%s, ?, ?::jsonb)", column), pgPath, toJSON(value))
+}
+
+func toJSON(value interface{}) string {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return "null"
+	}
+	return string(data)
+}
@@ -0,0 +1,96 @@
+package jsonbq
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"gorm.io/gorm/clause"
+)
+
+// render mimics the placeholder scanning clause.Expr.Build does internally:
+// each literal '?' in SQL consumes the next Var in order, and once Vars are
+// exhausted a '?' is written through unchanged. Asserting on this instead
+// of the raw SQL template is what catches an operator and a bound Var
+// fighting over the same '?'.
+func render(expr clause.Expr) string {
+	var b strings.Builder
+	idx := 0
+	for i := 0; i < len(expr.SQL); i++ {
+		c := expr.SQL[i]
+		if c == '?' && idx < len(expr.Vars) {
+			fmt.Fprintf(&b, "$%d", idx+1)
+			idx++
+			continue
+		}
+		b.WriteByte(c)
+	}
+	return b.String()
+}
+
+func TestPathBuildsNestedArrowChain(t *testing.T) {
+	expr := Path("data", "a", "b")
+	if expr.SQL != "data->?->>?" {
+		t.Fatalf("unexpected SQL: %q", expr.SQL)
+	}
+	if len(expr.Vars) != 2 || expr.Vars[0] != "a" || expr.Vars[1] != "b" {
+		t.Fatalf("unexpected vars: %#v", expr.Vars)
+	}
+}
+
+func TestPathWithNoSegmentsReturnsBareColumn(t *testing.T) {
+	expr := Path("data")
+	if expr.SQL != "data" {
+		t.Fatalf("unexpected SQL: %q", expr.SQL)
+	}
+	if len(expr.Vars) != 0 {
+		t.Fatalf("expected no vars, got %#v", expr.Vars)
+	}
+}
+
+func TestContainsBuildsContainmentExpr(t *testing.T) {
+	expr := Contains("data", map[string]any{"a": 1})
+	if expr.SQL != "data @> ?::jsonb" {
+		t.Fatalf("unexpected SQL: %q", expr.SQL)
+	}
+	if len(expr.Vars) != 1 || expr.Vars[0] != `{"a":1}` {
+		t.Fatalf("unexpected vars: %#v", expr.Vars)
+	}
+}
+
+func TestHasKeyRendersLiteralOperator(t *testing.T) {
+	expr := HasKey("data", "a")
+	if len(expr.Vars) != 0 {
+		t.Fatalf("expected no vars, got %#v", expr.Vars)
+	}
+	if got := render(expr); got != "data ? 'a'" {
+		t.Fatalf("unexpected rendered SQL: %q", got)
+	}
+}
+
+func TestHasKeyEscapesEmbeddedQuotes(t *testing.T) {
+	expr := HasKey("data", "o'brien")
+	if got := render(expr); got != "data ? 'o''brien'" {
+		t.Fatalf("unexpected rendered SQL: %q", got)
+	}
+}
+
+func TestPathExistsBuildsJsonpathExpr(t *testing.T) {
+	expr := PathExists("data", "$.a")
+	if got := render(expr); got != "data @? '$.a'::jsonpath" {
+		t.Fatalf("unexpected rendered SQL: %q", got)
+	}
+	if len(expr.Vars) != 0 {
+		t.Fatalf("expected no vars, got %#v", expr.Vars)
+	}
+}
+
+func TestSetBuildsJsonbSetExpr(t *testing.T) {
+	expr := Set("data", []string{"a", "b"}, 1)
+	if expr.SQL != "jsonb_set(data, ?, ?::jsonb)" {
+		t.Fatalf("unexpected SQL: %q", expr.SQL)
+	}
+	if len(expr.Vars) != 2 || expr.Vars[0] != "{a,b}" || expr.Vars[1] != "1" {
+		t.Fatalf("unexpected vars: %#v", expr.Vars)
+	}
+}
@@ -0,0 +1,58 @@
+// Package migrate runs versioned migrations against a sql.DBManager, backed
+// by a schema_migrations table and a PostgreSQL advisory lock so concurrent
+// instances of the same service apply migrations safely.
+package migrate
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Step applies or reverts a single migration within a transaction.
+type Step func(tx *gorm.DB) error
+
+// Migration pairs an up/down Step with its version and name. Versions loaded
+// from embedded SQL files and versions registered via Register are merged
+// and ordered together.
+type Migration struct {
+	Version  int64
+	Name     string
+	Up       Step
+	Down     Step
+	Checksum []byte
+}
+
+// MigrationStatus reports whether a migration has been applied.
+type MigrationStatus struct {
+	Version   int64
+	Name      string
+	Applied   bool
+	AppliedAt *time.Time
+	Checksum  []byte
+}
+
+// Runner applies and reports on versioned migrations. PostgresRunner
+// implements it here, and mongo/migrate's DocumentRunner mirrors it so both
+// backends are driven through the same interface.
+type Runner interface {
+	Up(ctx context.Context, target int64) error
+	Down(ctx context.Context, target int64) error
+	Status(ctx context.Context) ([]MigrationStatus, error)
+	Force(ctx context.Context, version int64) error
+}
+
+var registry []Migration
+
+// Register adds a programmatic migration step, for callers that prefer Go
+// code over embedded SQL files. Registered migrations are merged with any
+// loaded from an fs.FS when a PostgresRunner is built.
+//
+// checksum should be a stable digest of the migration's logic (e.g. a hash
+// of its source) so PostgresRunner.Up and Status can detect an
+// already-applied migration being edited later. Pass nil to fall back to a
+// weaker identity check based on version and name alone.
+func Register(version int64, name string, up, down Step, checksum []byte) {
+	registry = append(registry, Migration{Version: version, Name: name, Up: up, Down: down, Checksum: checksum})
+}
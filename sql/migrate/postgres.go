@@ -0,0 +1,340 @@
+package migrate
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+
+	dbsql "github.com/kerimovok/go-pkg-database/sql"
+	"gorm.io/gorm"
+)
+
+var fileNamePattern = regexp.MustCompile(`^(\d+)_(.+)\.(up|down)\.sql$`)
+
+// PostgresRunner applies migrations to a PostgreSQL database managed by a
+// dbsql.DBManager.
+type PostgresRunner struct {
+	dm         *dbsql.DBManager
+	dbName     string
+	migrations []Migration
+}
+
+// New builds a PostgresRunner from SQL files named NNNN_name.up.sql /
+// NNNN_name.down.sql (typically backed by an embed.FS) merged with any
+// migrations registered programmatically via Register.
+func New(dm *dbsql.DBManager, dbName string, files fs.FS) (*PostgresRunner, error) {
+	if dm == nil {
+		return nil, fmt.Errorf("database manager is nil")
+	}
+
+	fromFiles, err := loadFileMigrations(files)
+	if err != nil {
+		return nil, err
+	}
+
+	all := append(fromFiles, registry...)
+	sort.Slice(all, func(i, j int) bool { return all[i].Version < all[j].Version })
+
+	for i := 1; i < len(all); i++ {
+		if all[i].Version == all[i-1].Version {
+			return nil, fmt.Errorf("duplicate migration version %d", all[i].Version)
+		}
+	}
+
+	return &PostgresRunner{dm: dm, dbName: dbName, migrations: all}, nil
+}
+
+// fileMigration tracks the raw SQL alongside the Migration built from it, so
+// a checksum of the actual migration body can be computed once both the up
+// and down files for a version have been read.
+type fileMigration struct {
+	Migration
+	upData, downData []byte
+}
+
+func loadFileMigrations(files fs.FS) ([]Migration, error) {
+	if files == nil {
+		return nil, nil
+	}
+
+	byVersion := map[int64]*fileMigration{}
+
+	err := fs.WalkDir(files, ".", func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+
+		m := fileNamePattern.FindStringSubmatch(path.Base(p))
+		if m == nil {
+			return nil
+		}
+
+		version, err := strconv.ParseInt(m[1], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid migration version in %q: %w", p, err)
+		}
+
+		data, err := fs.ReadFile(files, p)
+		if err != nil {
+			return fmt.Errorf("failed to read migration file %q: %w", p, err)
+		}
+
+		entry, ok := byVersion[version]
+		if !ok {
+			entry = &fileMigration{Migration: Migration{Version: version, Name: m[2]}}
+			byVersion[version] = entry
+		}
+
+		switch m[3] {
+		case "up":
+			entry.upData = data
+			entry.Up = sqlFileStep(data)
+		case "down":
+			entry.downData = data
+			entry.Down = sqlFileStep(data)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]Migration, 0, len(byVersion))
+	for _, entry := range byVersion {
+		entry.Checksum = fileChecksum(entry.upData, entry.downData)
+		out = append(out, entry.Migration)
+	}
+	return out, nil
+}
+
+func sqlFileStep(data []byte) Step {
+	return func(tx *gorm.DB) error {
+		return tx.Exec(string(data)).Error
+	}
+}
+
+// fileChecksum hashes a migration's actual up/down SQL, so editing an
+// already-applied file is detected as drift.
+func fileChecksum(up, down []byte) []byte {
+	h := sha256.New()
+	h.Write(up)
+	h.Write(down)
+	return h.Sum(nil)
+}
+
+// effectiveChecksum returns m.Checksum, falling back to a weaker
+// version+name identity hash for migrations registered without one.
+func effectiveChecksum(m Migration) []byte {
+	if len(m.Checksum) > 0 {
+		return m.Checksum
+	}
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%d:%s", m.Version, m.Name)))
+	return sum[:]
+}
+
+// withLock reserves a connection and holds a pg_advisory_lock keyed by a
+// hash of the database name for its duration, so concurrent instances
+// serialize on migrations.
+func (r *PostgresRunner) withLock(ctx context.Context, fn func() error) error {
+	conn, err := r.dm.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to reserve connection for migration lock: %w", err)
+	}
+	defer conn.Close()
+
+	if _, err := conn.ExecContext(ctx, "SELECT pg_advisory_lock(hashtext($1))", r.dbName); err != nil {
+		return fmt.Errorf("failed to acquire migration lock: %w", err)
+	}
+	defer conn.ExecContext(ctx, "SELECT pg_advisory_unlock(hashtext($1))", r.dbName)
+
+	return fn()
+}
+
+func (r *PostgresRunner) ensureSchema(ctx context.Context) error {
+	return r.dm.WithContext(ctx).Exec(`
+		CREATE TABLE IF NOT EXISTS schema_migrations (
+			version bigint PRIMARY KEY,
+			name text NOT NULL,
+			applied_at timestamptz NOT NULL DEFAULT now(),
+			checksum bytea NOT NULL
+		)
+	`).Error
+}
+
+// appliedVersions returns the checksum stored for every applied version, so
+// callers can detect a migration that was edited after being applied.
+func (r *PostgresRunner) appliedVersions(ctx context.Context) (map[int64][]byte, error) {
+	type row struct {
+		Version  int64
+		Checksum []byte
+	}
+	var rows []row
+	if err := r.dm.WithContext(ctx).Raw("SELECT version, checksum FROM schema_migrations").Scan(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to read schema_migrations: %w", err)
+	}
+
+	applied := make(map[int64][]byte, len(rows))
+	for _, rw := range rows {
+		applied[rw.Version] = rw.Checksum
+	}
+	return applied, nil
+}
+
+// Up applies all pending migrations up to and including target. A target of
+// 0 applies every pending migration.
+func (r *PostgresRunner) Up(ctx context.Context, target int64) error {
+	return r.withLock(ctx, func() error {
+		if err := r.ensureSchema(ctx); err != nil {
+			return fmt.Errorf("failed to ensure schema_migrations table: %w", err)
+		}
+
+		applied, err := r.appliedVersions(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, m := range r.migrations {
+			if target > 0 && m.Version > target {
+				break
+			}
+
+			want := effectiveChecksum(m)
+			if got, ok := applied[m.Version]; ok {
+				if !bytes.Equal(got, want) {
+					return fmt.Errorf("migration %d_%s has drifted: stored checksum %x does not match current checksum %x", m.Version, m.Name, got, want)
+				}
+				continue
+			}
+			if m.Up == nil {
+				return fmt.Errorf("migration %d_%s has no up step", m.Version, m.Name)
+			}
+
+			if err := dbsql.WithTransactionContext(ctx, r.dm.DB, func(tx *gorm.DB) error {
+				if err := m.Up(tx); err != nil {
+					return err
+				}
+				return tx.Exec(
+					"INSERT INTO schema_migrations (version, name, applied_at, checksum) VALUES (?, ?, ?, ?)",
+					m.Version, m.Name, time.Now(), want,
+				).Error
+			}); err != nil {
+				return fmt.Errorf("failed to apply migration %d_%s: %w", m.Version, m.Name, err)
+			}
+		}
+
+		return nil
+	})
+}
+
+// Down rolls back applied migrations down to (but not including) target.
+func (r *PostgresRunner) Down(ctx context.Context, target int64) error {
+	return r.withLock(ctx, func() error {
+		applied, err := r.appliedVersions(ctx)
+		if err != nil {
+			return err
+		}
+
+		for i := len(r.migrations) - 1; i >= 0; i-- {
+			m := r.migrations[i]
+			if _, ok := applied[m.Version]; m.Version <= target || !ok {
+				continue
+			}
+			if m.Down == nil {
+				return fmt.Errorf("migration %d_%s has no down step", m.Version, m.Name)
+			}
+
+			if err := dbsql.WithTransactionContext(ctx, r.dm.DB, func(tx *gorm.DB) error {
+				if err := m.Down(tx); err != nil {
+					return err
+				}
+				return tx.Exec("DELETE FROM schema_migrations WHERE version = ?", m.Version).Error
+			}); err != nil {
+				return fmt.Errorf("failed to roll back migration %d_%s: %w", m.Version, m.Name, err)
+			}
+		}
+
+		return nil
+	})
+}
+
+// Status reports the up/down state of every known migration.
+func (r *PostgresRunner) Status(ctx context.Context) ([]MigrationStatus, error) {
+	if err := r.ensureSchema(ctx); err != nil {
+		return nil, fmt.Errorf("failed to ensure schema_migrations table: %w", err)
+	}
+
+	type row struct {
+		Version   int64
+		Name      string
+		AppliedAt time.Time
+		Checksum  []byte
+	}
+	var rows []row
+	if err := r.dm.WithContext(ctx).Raw("SELECT version, name, applied_at, checksum FROM schema_migrations").Scan(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to read schema_migrations: %w", err)
+	}
+
+	byVersion := make(map[int64]row, len(rows))
+	for _, rw := range rows {
+		byVersion[rw.Version] = rw
+	}
+
+	statuses := make([]MigrationStatus, 0, len(r.migrations))
+	for _, m := range r.migrations {
+		rw, applied := byVersion[m.Version]
+		status := MigrationStatus{Version: m.Version, Name: m.Name, Applied: applied}
+		if applied {
+			appliedAt := rw.AppliedAt
+			status.AppliedAt = &appliedAt
+			status.Checksum = rw.Checksum
+
+			if want := effectiveChecksum(m); !bytes.Equal(rw.Checksum, want) {
+				return nil, fmt.Errorf("migration %d_%s has drifted: stored checksum %x does not match current checksum %x", m.Version, m.Name, rw.Checksum, want)
+			}
+		}
+		statuses = append(statuses, status)
+	}
+
+	return statuses, nil
+}
+
+// Force rewrites schema_migrations to mark version as the latest applied
+// migration without running any steps, for repairing a dirty state.
+func (r *PostgresRunner) Force(ctx context.Context, version int64) error {
+	return r.withLock(ctx, func() error {
+		if err := r.ensureSchema(ctx); err != nil {
+			return err
+		}
+
+		return dbsql.WithTransactionContext(ctx, r.dm.DB, func(tx *gorm.DB) error {
+			if err := tx.Exec("DELETE FROM schema_migrations WHERE version > ?", version).Error; err != nil {
+				return err
+			}
+			for _, m := range r.migrations {
+				if m.Version > version {
+					continue
+				}
+				if err := tx.Exec(
+					"INSERT INTO schema_migrations (version, name, applied_at, checksum) VALUES (?, ?, ?, ?) ON CONFLICT (version) DO NOTHING",
+					m.Version, m.Name, time.Now(), effectiveChecksum(m),
+				).Error; err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	})
+}
+
+var _ Runner = (*PostgresRunner)(nil)
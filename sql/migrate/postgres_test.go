@@ -0,0 +1,34 @@
+package migrate
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestFileChecksumDetectsContentChange(t *testing.T) {
+	original := fileChecksum([]byte("CREATE TABLE foo (id int);"), []byte("DROP TABLE foo;"))
+	edited := fileChecksum([]byte("CREATE TABLE foo (id int, name text);"), []byte("DROP TABLE foo;"))
+
+	if bytes.Equal(original, edited) {
+		t.Fatal("expected checksum to change when migration content changes")
+	}
+
+	repeat := fileChecksum([]byte("CREATE TABLE foo (id int);"), []byte("DROP TABLE foo;"))
+	if !bytes.Equal(original, repeat) {
+		t.Fatal("expected checksum to be stable for identical content")
+	}
+}
+
+func TestEffectiveChecksumFallsBackToIdentityHash(t *testing.T) {
+	m := Migration{Version: 1, Name: "create_foo"}
+
+	fallback := effectiveChecksum(m)
+	if len(fallback) == 0 {
+		t.Fatal("expected a non-empty fallback checksum")
+	}
+
+	m.Checksum = []byte("explicit")
+	if !bytes.Equal(effectiveChecksum(m), m.Checksum) {
+		t.Fatal("expected effectiveChecksum to prefer an explicit checksum")
+	}
+}
@@ -0,0 +1,54 @@
+// Package outbox implements the transactional outbox pattern: domain writes
+// and the events they produce commit atomically in the same transaction,
+// and a Relay worker delivers those events to a message broker afterwards.
+package outbox
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	dbsql "github.com/kerimovok/go-pkg-database/sql"
+	"gorm.io/gorm"
+)
+
+// Event is an outbox row representing a domain event to be published
+// alongside the transaction that produced it.
+type Event struct {
+	ID            uuid.UUID   `gorm:"type:uuid;default:gen_random_uuid();primaryKey" json:"id"`
+	Aggregate     string      `gorm:"index;not null" json:"aggregate"`
+	Type          string      `gorm:"index;not null" json:"type"`
+	Payload       dbsql.JSONB `gorm:"type:jsonb" json:"payload"`
+	Headers       dbsql.JSONB `gorm:"type:jsonb" json:"headers"`
+	CreatedAt     time.Time   `gorm:"index;not null" json:"createdAt"`
+	PublishedAt   *time.Time  `gorm:"index" json:"publishedAt,omitempty"`
+	Attempts      int         `gorm:"not null;default:0" json:"attempts"`
+	NextAttemptAt time.Time   `gorm:"index;not null" json:"nextAttemptAt"`
+}
+
+// TableName overrides gorm's pluralized default so the table reads as
+// "outbox_events" rather than "events"
+func (Event) TableName() string {
+	return "outbox_events"
+}
+
+// BeforeCreate hook to ensure ID and NextAttemptAt are set
+func (e *Event) BeforeCreate(tx *gorm.DB) error {
+	if e.ID == uuid.Nil {
+		e.ID = uuid.New()
+	}
+	if e.NextAttemptAt.IsZero() {
+		e.NextAttemptAt = time.Now()
+	}
+	return nil
+}
+
+// Publish inserts evt. It is designed to be called inside
+// sql.WithTransaction / sql.WithNestedTransaction so domain writes and the
+// event row commit atomically.
+func Publish(tx *gorm.DB, evt Event) error {
+	if err := tx.Create(&evt).Error; err != nil {
+		return fmt.Errorf("failed to publish outbox event: %w", err)
+	}
+	return nil
+}
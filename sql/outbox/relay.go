@@ -0,0 +1,146 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	dbsql "github.com/kerimovok/go-pkg-database/sql"
+	"gorm.io/gorm"
+)
+
+// Dispatcher hands an outbox event off to a message broker or HTTP
+// endpoint. Kafka/NATS/HTTP implementations live in their own sub-packages
+// and only need to satisfy this interface.
+type Dispatcher interface {
+	Dispatch(ctx context.Context, evt Event) error
+}
+
+// defaultLeaseDuration bounds how long a claimed event is held out of the
+// next Poll's claim query while it is being dispatched outside any
+// transaction, so a crashed or slow poller doesn't permanently strand it.
+const defaultLeaseDuration = 30 * time.Second
+
+// Relay polls unpublished outbox rows and hands them to a Dispatcher,
+// retrying with exponential backoff on failure.
+type Relay struct {
+	dm            *dbsql.DBManager
+	dispatcher    Dispatcher
+	batchSize     int
+	maxBackoff    time.Duration
+	leaseDuration time.Duration
+}
+
+// RelayOption configures a Relay constructed via NewRelay.
+type RelayOption func(*Relay)
+
+// WithBatchSize sets how many due events Poll claims per call. Defaults to 100.
+func WithBatchSize(n int) RelayOption {
+	return func(r *Relay) { r.batchSize = n }
+}
+
+// WithMaxBackoff caps the exponential retry delay applied to failed events.
+// Defaults to 5 minutes.
+func WithMaxBackoff(d time.Duration) RelayOption {
+	return func(r *Relay) { r.maxBackoff = d }
+}
+
+// WithLeaseDuration sets how long a claimed event is protected from being
+// claimed again by a concurrent Poll while it is being dispatched. Defaults
+// to 30 seconds.
+func WithLeaseDuration(d time.Duration) RelayOption {
+	return func(r *Relay) { r.leaseDuration = d }
+}
+
+// NewRelay builds a Relay that dispatches due outbox events through dispatcher.
+func NewRelay(dm *dbsql.DBManager, dispatcher Dispatcher, opts ...RelayOption) *Relay {
+	r := &Relay{dm: dm, dispatcher: dispatcher, batchSize: 100, maxBackoff: 5 * time.Minute, leaseDuration: defaultLeaseDuration}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// Poll claims up to the Relay's batch size of due, unpublished events with
+// SELECT ... FOR UPDATE SKIP LOCKED, leasing each by pushing its
+// next_attempt_at out so a concurrent Poll can't reclaim it mid-dispatch.
+// The claim commits immediately; dispatching then happens outside any
+// transaction so a slow or unavailable downstream doesn't hold the claiming
+// transaction's row locks open. Each outcome is recorded in its own short
+// update. It returns the number of events successfully dispatched.
+func (r *Relay) Poll(ctx context.Context) (int, error) {
+	events, err := r.claim(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	dispatched := 0
+	for _, evt := range events {
+		if err := r.dispatcher.Dispatch(ctx, evt); err != nil {
+			attempts := evt.Attempts + 1
+			nextAttemptAt := time.Now().Add(backoff(attempts, r.maxBackoff))
+			if updErr := r.dm.WithContext(ctx).Model(&Event{}).Where("id = ?", evt.ID).Updates(map[string]interface{}{
+				"attempts":        attempts,
+				"next_attempt_at": nextAttemptAt,
+			}).Error; updErr != nil {
+				return dispatched, fmt.Errorf("failed to schedule retry for event %s: %w", evt.ID, updErr)
+			}
+			continue
+		}
+
+		if err := r.dm.WithContext(ctx).Model(&Event{}).Where("id = ?", evt.ID).Update("published_at", time.Now()).Error; err != nil {
+			return dispatched, fmt.Errorf("failed to mark event %s published: %w", evt.ID, err)
+		}
+		dispatched++
+	}
+
+	return dispatched, nil
+}
+
+// claim atomically selects due, unpublished events and extends their
+// next_attempt_at by the Relay's lease duration, all within a single short
+// transaction, then returns them for dispatch outside of it.
+func (r *Relay) claim(ctx context.Context) ([]Event, error) {
+	var events []Event
+
+	err := dbsql.WithTransactionContext(ctx, r.dm.DB, func(tx *gorm.DB) error {
+		return tx.Raw(`
+			UPDATE outbox_events SET next_attempt_at = ?
+			WHERE id IN (
+				SELECT id FROM outbox_events
+				WHERE published_at IS NULL AND next_attempt_at <= ?
+				ORDER BY next_attempt_at
+				LIMIT ?
+				FOR UPDATE SKIP LOCKED
+			)
+			RETURNING *
+		`, time.Now().Add(r.leaseDuration), time.Now(), r.batchSize).Scan(&events).Error
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim outbox events: %w", err)
+	}
+
+	return events, nil
+}
+
+func backoff(attempts int, max time.Duration) time.Duration {
+	if attempts <= 0 || attempts >= 32 {
+		return max
+	}
+	if d := time.Second << uint(attempts); d > 0 && d < max {
+		return d
+	}
+	return max
+}
+
+// Cleanup hard-deletes published events older than olderThan so the table
+// does not grow unbounded.
+func (r *Relay) Cleanup(ctx context.Context, olderThan time.Duration) (int64, error) {
+	res := r.dm.WithContext(ctx).
+		Where("published_at IS NOT NULL AND published_at < ?", time.Now().Add(-olderThan)).
+		Delete(&Event{})
+	if res.Error != nil {
+		return 0, fmt.Errorf("failed to clean up outbox events: %w", res.Error)
+	}
+	return res.RowsAffected, nil
+}
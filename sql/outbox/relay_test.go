@@ -0,0 +1,30 @@
+package outbox
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBackoffGrowsExponentiallyUpToMax(t *testing.T) {
+	max := 5 * time.Minute
+
+	prev := time.Duration(0)
+	for attempt := 1; attempt < 10; attempt++ {
+		d := backoff(attempt, max)
+		if d <= prev {
+			t.Fatalf("attempt %d: expected backoff to increase, got %s after %s", attempt, d, prev)
+		}
+		if d > max {
+			t.Fatalf("attempt %d: backoff %s exceeded max %s", attempt, d, max)
+		}
+		prev = d
+	}
+
+	if d := backoff(1000, max); d != max {
+		t.Fatalf("expected backoff to clamp to max for large attempt counts, got %s", d)
+	}
+
+	if d := backoff(0, max); d != max {
+		t.Fatalf("expected backoff(0, max) to clamp to max, got %s", d)
+	}
+}
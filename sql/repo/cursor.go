@@ -0,0 +1,94 @@
+package repo
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// Cursor is an opaque pagination position over an indexed (CreatedAt, ID)
+// tuple, avoiding offset scans on large tables.
+type Cursor struct {
+	CreatedAt time.Time
+	ID        string
+}
+
+func encodeCursor(c Cursor) string {
+	data, _ := json.Marshal(c)
+	return base64.URLEncoding.EncodeToString(data)
+}
+
+func decodeCursor(s string) (Cursor, error) {
+	var c Cursor
+	data, err := base64.URLEncoding.DecodeString(s)
+	if err != nil {
+		return c, fmt.Errorf("invalid cursor: %w", err)
+	}
+	if err := json.Unmarshal(data, &c); err != nil {
+		return c, fmt.Errorf("invalid cursor: %w", err)
+	}
+	return c, nil
+}
+
+// Page is a cursor-paginated result set.
+type Page[T any] struct {
+	Items      []T
+	NextCursor string
+	HasMore    bool
+}
+
+// Paginate returns up to limit rows matching q created after cursor (pass ""
+// for the first page), ordered by (created_at, id) ascending. T must have
+// CreatedAt time.Time and ID fields, as BaseModel provides.
+func (r *Repository[T, ID]) Paginate(ctx context.Context, q *Query, limit int, cursor string) (*Page[T], error) {
+	db := q.apply(r.db.WithContext(ctx)).Order("created_at, id")
+
+	if cursor != "" {
+		c, err := decodeCursor(cursor)
+		if err != nil {
+			return nil, err
+		}
+		db = db.Where("(created_at, id) > (?, ?)", c.CreatedAt, c.ID)
+	}
+
+	var items []T
+	if err := db.Limit(limit + 1).Find(&items).Error; err != nil {
+		return nil, fmt.Errorf("failed to paginate %T: %w", *new(T), err)
+	}
+
+	page := &Page[T]{}
+	if len(items) > limit {
+		page.HasMore = true
+		items = items[:limit]
+	}
+	page.Items = items
+
+	if len(items) > 0 {
+		createdAt, id, err := cursorFields(items[len(items)-1])
+		if err != nil {
+			return nil, err
+		}
+		page.NextCursor = encodeCursor(Cursor{CreatedAt: createdAt, ID: id})
+	}
+
+	return page, nil
+}
+
+func cursorFields(m any) (time.Time, string, error) {
+	v := reflect.ValueOf(m)
+	createdAt := v.FieldByName("CreatedAt")
+	id := v.FieldByName("ID")
+	if !createdAt.IsValid() || !id.IsValid() {
+		return time.Time{}, "", fmt.Errorf("cursor pagination requires CreatedAt and ID fields")
+	}
+
+	ts, ok := createdAt.Interface().(time.Time)
+	if !ok {
+		return time.Time{}, "", fmt.Errorf("CreatedAt field must be time.Time")
+	}
+
+	return ts, fmt.Sprintf("%v", id.Interface()), nil
+}
@@ -0,0 +1,72 @@
+package repo
+
+import "gorm.io/gorm"
+
+// Query composes scopes applied by Repository's Find/FindOne/Count/Exists.
+//
+// BaseModel.DeletedAt is a plain *time.Time, not gorm.DeletedAt, so gorm
+// never wires up its automatic soft-delete scoping for it. Query manages
+// deleted_at itself instead: by default rows with a non-null deleted_at are
+// excluded, and WithDeleted/OnlyDeleted switch that off explicitly.
+type Query struct {
+	scopes      []func(*gorm.DB) *gorm.DB
+	withDeleted bool
+	onlyDeleted bool
+}
+
+// NewQuery starts an empty Query.
+func NewQuery() *Query {
+	return &Query{}
+}
+
+func (q *Query) apply(db *gorm.DB) *gorm.DB {
+	switch {
+	case q.onlyDeleted:
+		db = db.Where("deleted_at IS NOT NULL")
+	case !q.withDeleted:
+		db = db.Where("deleted_at IS NULL")
+	}
+	for _, scope := range q.scopes {
+		db = scope(db)
+	}
+	return db
+}
+
+// Where adds a condition, following gorm's Where argument conventions.
+func (q *Query) Where(query interface{}, args ...interface{}) *Query {
+	q.scopes = append(q.scopes, func(db *gorm.DB) *gorm.DB { return db.Where(query, args...) })
+	return q
+}
+
+// With preloads the given association paths, e.g. With("Author", "Comments.Author").
+func (q *Query) With(associations ...string) *Query {
+	for _, assoc := range associations {
+		assoc := assoc
+		q.scopes = append(q.scopes, func(db *gorm.DB) *gorm.DB { return db.Preload(assoc) })
+	}
+	return q
+}
+
+// Order adds an ORDER BY clause.
+func (q *Query) Order(order string) *Query {
+	q.scopes = append(q.scopes, func(db *gorm.DB) *gorm.DB { return db.Order(order) })
+	return q
+}
+
+// Limit adds a LIMIT clause.
+func (q *Query) Limit(n int) *Query {
+	q.scopes = append(q.scopes, func(db *gorm.DB) *gorm.DB { return db.Limit(n) })
+	return q
+}
+
+// WithDeleted includes soft-deleted rows alongside live ones.
+func (q *Query) WithDeleted() *Query {
+	q.withDeleted = true
+	return q
+}
+
+// OnlyDeleted restricts results to soft-deleted rows.
+func (q *Query) OnlyDeleted() *Query {
+	q.onlyDeleted = true
+	return q
+}
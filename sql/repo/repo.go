@@ -0,0 +1,112 @@
+// Package repo provides a generic repository and unit-of-work layer on top
+// of gorm, so handlers get Create/Find/Paginate and transactional
+// composition without hand-writing the same CRUD boilerplate per model.
+package repo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Repository provides common CRUD and query operations for a gorm model T
+// identified by ID.
+type Repository[T any, ID comparable] struct {
+	db *gorm.DB
+}
+
+// New builds a Repository bound to db, typically a DBManager.DB or the
+// *gorm.DB handed to a transaction callback.
+func New[T any, ID comparable](db *gorm.DB) *Repository[T, ID] {
+	return &Repository[T, ID]{db: db}
+}
+
+// Create inserts m.
+func (r *Repository[T, ID]) Create(ctx context.Context, m *T) error {
+	if err := r.db.WithContext(ctx).Create(m).Error; err != nil {
+		return fmt.Errorf("failed to create %T: %w", *new(T), err)
+	}
+	return nil
+}
+
+// Update applies the non-zero fields of m to its existing row.
+func (r *Repository[T, ID]) Update(ctx context.Context, m *T) error {
+	if err := r.db.WithContext(ctx).Model(m).Updates(m).Error; err != nil {
+		return fmt.Errorf("failed to update %T: %w", *new(T), err)
+	}
+	return nil
+}
+
+// Save upserts m, writing every field regardless of zero value.
+func (r *Repository[T, ID]) Save(ctx context.Context, m *T) error {
+	if err := r.db.WithContext(ctx).Save(m).Error; err != nil {
+		return fmt.Errorf("failed to save %T: %w", *new(T), err)
+	}
+	return nil
+}
+
+// Delete soft-deletes the row identified by id. BaseModel.DeletedAt is a
+// plain *time.Time rather than gorm.DeletedAt, so gorm won't turn a plain
+// Delete into this UPDATE on its own; Repository sets deleted_at itself
+// instead of relying on gorm's automatic soft-delete.
+func (r *Repository[T, ID]) Delete(ctx context.Context, id ID) error {
+	var zero T
+	if err := r.db.WithContext(ctx).Model(&zero).Where("id = ?", id).Update("deleted_at", time.Now()).Error; err != nil {
+		return fmt.Errorf("failed to delete %T: %w", zero, err)
+	}
+	return nil
+}
+
+// Restore clears the soft-delete mark on the row identified by id.
+func (r *Repository[T, ID]) Restore(ctx context.Context, id ID) error {
+	var zero T
+	if err := r.db.WithContext(ctx).Model(&zero).Where("id = ?", id).Update("deleted_at", nil).Error; err != nil {
+		return fmt.Errorf("failed to restore %T: %w", zero, err)
+	}
+	return nil
+}
+
+// FindByID loads the row identified by id, excluding soft-deleted rows.
+func (r *Repository[T, ID]) FindByID(ctx context.Context, id ID) (*T, error) {
+	var m T
+	if err := r.db.WithContext(ctx).Where("deleted_at IS NULL").First(&m, "id = ?", id).Error; err != nil {
+		return nil, fmt.Errorf("failed to find %T by id: %w", m, err)
+	}
+	return &m, nil
+}
+
+// FindOne loads the first row matching q.
+func (r *Repository[T, ID]) FindOne(ctx context.Context, q *Query) (*T, error) {
+	var m T
+	if err := q.apply(r.db.WithContext(ctx)).First(&m).Error; err != nil {
+		return nil, fmt.Errorf("failed to find %T: %w", m, err)
+	}
+	return &m, nil
+}
+
+// Find loads every row matching q.
+func (r *Repository[T, ID]) Find(ctx context.Context, q *Query) ([]T, error) {
+	var ms []T
+	if err := q.apply(r.db.WithContext(ctx)).Find(&ms).Error; err != nil {
+		return nil, fmt.Errorf("failed to find %T: %w", *new(T), err)
+	}
+	return ms, nil
+}
+
+// Count returns the number of rows matching q.
+func (r *Repository[T, ID]) Count(ctx context.Context, q *Query) (int64, error) {
+	var count int64
+	var zero T
+	if err := q.apply(r.db.WithContext(ctx)).Model(&zero).Count(&count).Error; err != nil {
+		return 0, fmt.Errorf("failed to count %T: %w", zero, err)
+	}
+	return count, nil
+}
+
+// Exists reports whether any row matches q.
+func (r *Repository[T, ID]) Exists(ctx context.Context, q *Query) (bool, error) {
+	count, err := r.Count(ctx, q)
+	return count > 0, err
+}
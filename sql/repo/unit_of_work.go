@@ -0,0 +1,29 @@
+package repo
+
+import (
+	"context"
+
+	dbsql "github.com/kerimovok/go-pkg-database/sql"
+	"gorm.io/gorm"
+)
+
+// UnitOfWork carries the *gorm.DB for an in-flight transaction, so handlers
+// can build repositories bound to it without threading tx through every call.
+type UnitOfWork struct {
+	db *gorm.DB
+}
+
+// Do runs fn inside sql.WithNestedTransactionContext, handing it a
+// UnitOfWork bound to that transaction's *gorm.DB so it composes
+// multi-aggregate writes without callers managing tx manually.
+func Do(ctx context.Context, db *gorm.DB, fn func(uow *UnitOfWork) error) error {
+	return dbsql.WithNestedTransactionContext(ctx, db, func(tx *gorm.DB) error {
+		return fn(&UnitOfWork{db: tx})
+	})
+}
+
+// Of returns a Repository for model T bound to the UnitOfWork's current
+// transaction.
+func Of[T any, ID comparable](uow *UnitOfWork) *Repository[T, ID] {
+	return New[T, ID](uow.db)
+}
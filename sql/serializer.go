@@ -0,0 +1,62 @@
+package sql
+
+import (
+	"bytes"
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"gorm.io/gorm/schema"
+)
+
+// jsonbSerializer implements schema.SerializerInterface, registered under
+// the "jsonb" name so struct fields tagged `gorm:"serializer:jsonb"`
+// round-trip through JSONB semantics (UseNumber preserved) without needing
+// the JSONB or JSONBOf wrapper types.
+type jsonbSerializer struct{}
+
+func init() {
+	schema.RegisterSerializer("jsonb", jsonbSerializer{})
+}
+
+// Scan implements schema.SerializerInterface
+func (jsonbSerializer) Scan(ctx context.Context, field *schema.Field, dst reflect.Value, dbValue interface{}) error {
+	if dbValue == nil {
+		return nil
+	}
+
+	var data []byte
+	switch v := dbValue.(type) {
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return fmt.Errorf("cannot scan %T into jsonb field %s", dbValue, field.Name)
+	}
+
+	if len(data) == 0 {
+		return nil
+	}
+
+	fieldValue := reflect.New(field.FieldType)
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(fieldValue.Interface()); err != nil {
+		return fmt.Errorf("failed to unmarshal jsonb field %s: %w", field.Name, err)
+	}
+
+	field.ReflectValueOf(ctx, dst).Set(fieldValue.Elem())
+	return nil
+}
+
+// Value implements schema.SerializerInterface
+func (jsonbSerializer) Value(ctx context.Context, field *schema.Field, dst reflect.Value, fieldValue interface{}) (interface{}, error) {
+	data, err := json.Marshal(fieldValue)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal jsonb field %s: %w", field.Name, err)
+	}
+	return driver.Value(data), nil
+}
@@ -0,0 +1,109 @@
+package sql
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+)
+
+const tracerName = "github.com/kerimovok/go-pkg-database/sql"
+
+// ObservabilityConfig controls optional tracing instrumentation for a
+// DBManager. It is opt-in so existing callers are unaffected.
+type ObservabilityConfig struct {
+	Tracing bool
+}
+
+// tracingPlugin is a gorm.Plugin that creates an OpenTelemetry span for
+// every Create/Query/Update/Delete/Row/Raw callback, and emits a span event
+// when the query exceeds slowThreshold.
+type tracingPlugin struct {
+	slowThreshold time.Duration
+}
+
+func (tracingPlugin) Name() string {
+	return "otel-tracing"
+}
+
+// registration binds one gorm callback operation to the processor methods
+// that register hooks for it. db.Callback() only exposes named accessors
+// (Create, Query, Update, Delete, Row, Raw), not a lookup by string, so
+// Before/After are captured as method values here instead.
+type registration struct {
+	op     string
+	before func(name string, fn func(*gorm.DB)) error
+	after  func(name string, fn func(*gorm.DB)) error
+}
+
+func (p tracingPlugin) Initialize(db *gorm.DB) error {
+	cb := db.Callback()
+	regs := []registration{
+		{"create", cb.Create().Before("gorm:create").Register, cb.Create().After("gorm:create").Register},
+		{"query", cb.Query().Before("gorm:query").Register, cb.Query().After("gorm:query").Register},
+		{"update", cb.Update().Before("gorm:update").Register, cb.Update().After("gorm:update").Register},
+		{"delete", cb.Delete().Before("gorm:delete").Register, cb.Delete().After("gorm:delete").Register},
+		{"row", cb.Row().Before("gorm:row").Register, cb.Row().After("gorm:row").Register},
+		{"raw", cb.Raw().Before("gorm:raw").Register, cb.Raw().After("gorm:raw").Register},
+	}
+
+	for _, r := range regs {
+		if err := r.before("otel:before_"+r.op, p.before(r.op)); err != nil {
+			return err
+		}
+		if err := r.after("otel:after_"+r.op, p.after(r.op)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type tracingState struct {
+	span  trace.Span
+	start time.Time
+}
+
+type tracingStateKey struct{}
+
+func (p tracingPlugin) before(op string) func(*gorm.DB) {
+	return func(tx *gorm.DB) {
+		ctx, span := otel.Tracer(tracerName).Start(tx.Statement.Context, "gorm."+op)
+		span.SetAttributes(attribute.String("db.system", "postgresql"))
+		ctx = context.WithValue(ctx, tracingStateKey{}, &tracingState{span: span, start: time.Now()})
+		tx.Statement.Context = ctx
+	}
+}
+
+func (p tracingPlugin) after(op string) func(*gorm.DB) {
+	return func(tx *gorm.DB) {
+		state, ok := tx.Statement.Context.Value(tracingStateKey{}).(*tracingState)
+		if !ok {
+			return
+		}
+		defer state.span.End()
+
+		state.span.SetAttributes(
+			attribute.String("db.statement", tx.Statement.SQL.String()),
+			attribute.Int64("db.rows_affected", tx.Statement.RowsAffected),
+		)
+
+		if tx.Error != nil {
+			state.span.RecordError(tx.Error)
+			state.span.SetStatus(codes.Error, tx.Error.Error())
+		}
+
+		if p.slowThreshold > 0 {
+			if elapsed := time.Since(state.start); elapsed > p.slowThreshold {
+				state.span.AddEvent("slow_query", trace.WithAttributes(
+					attribute.Stringer("duration", elapsed),
+					attribute.Stringer("threshold", p.slowThreshold),
+				))
+			}
+		}
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"gorm.io/gorm"
+	"gorm.io/plugin/dbresolver"
 )
 
 // TransactionFunc defines the function signature for transaction operations
@@ -47,6 +48,17 @@ func WithTransactionContext(ctx context.Context, db *gorm.DB, fn TransactionFunc
 	return nil
 }
 
+// WithReadOnlyTransactionContext executes fn within a transaction pinned to a
+// read replica via dbresolver.Read, for callers that want a consistent
+// snapshot across several replica reads rather than routing each query
+// independently.
+func WithReadOnlyTransactionContext(ctx context.Context, db *gorm.DB, fn TransactionFunc) error {
+	if db == nil {
+		return fmt.Errorf("database connection is nil")
+	}
+	return WithTransactionContext(ctx, db.Clauses(dbresolver.Read), fn)
+}
+
 // WithNestedTransaction handles nested transactions using savepoints
 func WithNestedTransaction(db *gorm.DB, fn TransactionFunc) error {
 	return WithNestedTransactionContext(context.Background(), db, fn)